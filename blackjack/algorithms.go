@@ -0,0 +1,191 @@
+package blackjack
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// NewDealerPlayAlgorithm builds a dealer PlayAlgorithm. When hitSoft17 is
+// true, the dealer hits a soft 17 instead of standing on it.
+func NewDealerPlayAlgorithm(hitSoft17 bool) PlayAlgorithm {
+	return func(dealer *Player, hand *Hand, dealerUpCard Card, legal []Action) Action {
+		value := hand.Value()
+		if value < 17 {
+			return ActionHit
+		}
+		if value == 17 && hitSoft17 && hand.IsSoft() {
+			return ActionHit
+		}
+		return ActionStand
+	}
+}
+
+func DealerBetAlgorithm(dealer *Player, decksRemaining float64) int {
+	return 0
+}
+
+func legalActionNames(legal []Action) string {
+	var names []string
+	for _, action := range legal {
+		names = append(names, action.String())
+	}
+	return strings.Join(names, ", ")
+}
+
+func HumanPlayAlgorithm(player *Player, hand *Hand, dealerUpCard Card, legal []Action) Action {
+	fmt.Printf("Your options are: %s\n", legalActionNames(legal))
+	var input string
+	fmt.Scanln(&input)
+	for _, action := range legal {
+		if action.String() == input {
+			return action
+		}
+	}
+	return ActionStand
+}
+
+func HumanBetAlgorithm(player *Player, decksRemaining float64) int {
+	fmt.Printf("%s, how much would you like to bet?\n", player.Name)
+	var bet int
+	fmt.Scanln(&bet)
+	return bet
+}
+
+// BrucePlayAlgorithm is Bruce's naive strategy: hit on anything under 15,
+// otherwise stand.
+func BrucePlayAlgorithm(player *Player, hand *Hand, dealerUpCard Card, legal []Action) Action {
+	if hand.Value() < 15 {
+		return ActionHit
+	}
+	return ActionStand
+}
+
+// BruceBetAlgorithm doubles Bruce's bet on every loss, up to 3 losses in a
+// row, then resets to the table minimum.
+func BruceBetAlgorithm(player *Player, decksRemaining float64) int {
+	var bet int
+	if player.LoseStreak < 3 {
+		bet = 5 * int(math.Pow(2, float64(player.LoseStreak)))
+	} else {
+		bet = 5
+		player.LoseStreak = 0
+	}
+	return bet
+}
+
+// uniformRow builds a basic-strategy table row that plays action regardless
+// of the dealer's up card.
+func uniformRow(action Action) map[int]Action {
+	row := map[int]Action{}
+	for up := 2; up <= 11; up++ {
+		row[up] = action
+	}
+	return row
+}
+
+// rowWithRange is like uniformRow, but plays override against dealer up
+// cards in [lo, hi] instead of the base action.
+func rowWithRange(base, override Action, lo, hi int) map[int]Action {
+	row := uniformRow(base)
+	for up := lo; up <= hi; up++ {
+		row[up] = override
+	}
+	return row
+}
+
+// hardStrategyTable maps a hard player total to the basic-strategy action
+// for each dealer up card (2-11, where 11 is an ace).
+var hardStrategyTable = map[int]map[int]Action{
+	9:  rowWithRange(ActionHit, ActionDouble, 3, 6),
+	10: rowWithRange(ActionHit, ActionDouble, 2, 9),
+	11: rowWithRange(ActionHit, ActionDouble, 2, 10),
+	12: rowWithRange(ActionHit, ActionStand, 4, 6),
+	13: rowWithRange(ActionHit, ActionStand, 2, 6),
+	14: rowWithRange(ActionHit, ActionStand, 2, 6),
+	15: rowWithRange(ActionHit, ActionStand, 2, 6),
+	16: rowWithRange(ActionHit, ActionStand, 2, 6),
+}
+
+// softStrategyTable maps a soft player total (ace counted as 11) to the
+// basic-strategy action for each dealer up card.
+var softStrategyTable = map[int]map[int]Action{
+	13: rowWithRange(ActionHit, ActionDouble, 5, 6),
+	14: rowWithRange(ActionHit, ActionDouble, 5, 6),
+	15: rowWithRange(ActionHit, ActionDouble, 4, 6),
+	16: rowWithRange(ActionHit, ActionDouble, 4, 6),
+	17: rowWithRange(ActionHit, ActionDouble, 3, 6),
+	18: func() map[int]Action {
+		row := rowWithRange(ActionStand, ActionDouble, 3, 6)
+		row[9], row[10], row[11] = ActionHit, ActionHit, ActionHit
+		return row
+	}(),
+}
+
+// pairStrategyTable maps a pair's per-card value (ace counted as 11) to the
+// basic-strategy action for each dealer up card.
+var pairStrategyTable = map[int]map[int]Action{
+	11: uniformRow(ActionSplit),
+	10: uniformRow(ActionStand),
+	9: func() map[int]Action {
+		row := uniformRow(ActionSplit)
+		row[7], row[10], row[11] = ActionStand, ActionStand, ActionStand
+		return row
+	}(),
+	8: uniformRow(ActionSplit),
+	7: rowWithRange(ActionHit, ActionSplit, 2, 7),
+	6: rowWithRange(ActionHit, ActionSplit, 2, 6),
+	5: rowWithRange(ActionHit, ActionDouble, 2, 9),
+	4: rowWithRange(ActionHit, ActionSplit, 5, 6),
+	3: rowWithRange(ActionHit, ActionSplit, 2, 7),
+	2: rowWithRange(ActionHit, ActionSplit, 2, 7),
+}
+
+// BasicStrategyPlayAlgorithm looks the hand up in the hard/soft/pair basic
+// strategy tables, falling back to hitting when a table recommends an
+// action the hand isn't currently allowed to take (e.g. doubling after a
+// hit already happened).
+func BasicStrategyPlayAlgorithm(player *Player, hand *Hand, dealerUpCard Card, legal []Action) Action {
+	dealerUp := dealerUpCard.Value()
+
+	if hasLegalAction(legal, ActionSplit) {
+		if row, ok := pairStrategyTable[hand.Cards[0].Value()]; ok {
+			if action := row[dealerUp]; action == ActionSplit {
+				return action
+			}
+		}
+	}
+
+	var table map[int]map[int]Action
+	if hand.IsSoft() {
+		table = softStrategyTable
+	} else {
+		table = hardStrategyTable
+	}
+
+	row, ok := table[hand.Value()]
+	if !ok {
+		if hand.Value() >= 17 {
+			return ActionStand
+		}
+		return ActionHit
+	}
+
+	action := row[dealerUp]
+	if action == ActionDouble && !hasLegalAction(legal, ActionDouble) {
+		return ActionHit
+	}
+	return action
+}
+
+// CountingBetAlgorithm sizes the bet off the player's Hi-Lo true count: one
+// betting unit per true count above 1, floored at one unit.
+func CountingBetAlgorithm(player *Player, decksRemaining float64) int {
+	const unit = 10
+	trueCount := player.TrueCount(decksRemaining)
+	multiplier := trueCount - 1
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	return int(float64(unit) * multiplier)
+}
@@ -0,0 +1,164 @@
+package blackjack
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Suit is one of the four card suits, represented by its printed symbol.
+type Suit rune
+
+const (
+	Spade   Suit = '♠'
+	Heart   Suit = '♥'
+	Diamond Suit = '♦'
+	Club    Suit = '♣'
+)
+
+func (s Suit) String() string {
+	switch s {
+	case Spade:
+		return "Spades"
+	case Heart:
+		return "Hearts"
+	case Diamond:
+		return "Diamonds"
+	case Club:
+		return "Clubs"
+	}
+	return "Unknown"
+}
+
+// Rank is a card's rank, represented by its shorthand character (2-9, T,
+// J, Q, K, A).
+type Rank rune
+
+const (
+	Ace   Rank = 'A'
+	Two   Rank = '2'
+	Three Rank = '3'
+	Four  Rank = '4'
+	Five  Rank = '5'
+	Six   Rank = '6'
+	Seven Rank = '7'
+	Eight Rank = '8'
+	Nine  Rank = '9'
+	Ten   Rank = 'T'
+	Jack  Rank = 'J'
+	Queen Rank = 'Q'
+	King  Rank = 'K'
+)
+
+func (r Rank) String() string {
+	switch r {
+	case Ace:
+		return "Ace"
+	case Ten:
+		return "10"
+	case Jack:
+		return "Jack"
+	case Queen:
+		return "Queen"
+	case King:
+		return "King"
+	}
+	return string(rune(r))
+}
+
+// Suits is every suit in a standard deck, in the order a deck is built.
+var Suits = []Suit{Spade, Heart, Diamond, Club}
+
+// Ranks is every rank in a standard deck, in the order a deck is built.
+var Ranks = []Rank{Ace, Two, Three, Four, Five, Six, Seven, Eight, Nine, Ten, Jack, Queen, King}
+
+type Card struct {
+	Suit Suit
+	Rank Rank
+}
+
+func (c Card) String() string {
+	return fmt.Sprintf("%s of %s", c.Rank, c.Suit)
+}
+
+// Value returns the value of the card in blackjack. For aces, always
+// return the high value of 11; a Hand adjusts aces down to 1 as needed to
+// avoid busting.
+func (c Card) Value() int {
+	switch c.Rank {
+	case Ace:
+		return 11
+	case Ten, Jack, Queen, King:
+		return 10
+	}
+	return int(c.Rank - '0')
+}
+
+// FormatForTerminal returns the card rendered with ANSI colors: red for
+// hearts and diamonds, and the terminal's default color for spades and
+// clubs.
+func (c Card) FormatForTerminal() string {
+	switch c.Suit {
+	case Heart, Diamond:
+		return fmt.Sprintf("\033[31m%s\033[0m", c)
+	default:
+		return c.String()
+	}
+}
+
+// suitFromShorthand and rankFromShorthand translate the single-character
+// shorthand used by NewCardFromString (e.g. "s" or "T") into a Suit/Rank.
+func suitFromShorthand(c byte) (Suit, error) {
+	switch c {
+	case 's':
+		return Spade, nil
+	case 'h':
+		return Heart, nil
+	case 'd':
+		return Diamond, nil
+	case 'c':
+		return Club, nil
+	}
+	return 0, fmt.Errorf("unknown suit shorthand %q", c)
+}
+
+func rankFromShorthand(c byte) (Rank, error) {
+	r := Rank(c)
+	for _, known := range Ranks {
+		if known == r {
+			return r, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown rank shorthand %q", c)
+}
+
+// NewCardFromString parses a card from its two-character shorthand, rank
+// followed by suit, e.g. "As" for the ace of spades or "Td" for the ten of
+// diamonds.
+func NewCardFromString(s string) (Card, error) {
+	if len(s) != 2 {
+		return Card{}, fmt.Errorf("card shorthand must be 2 characters, got %q", s)
+	}
+	rank, err := rankFromShorthand(s[0])
+	if err != nil {
+		return Card{}, err
+	}
+	suit, err := suitFromShorthand(s[1])
+	if err != nil {
+		return Card{}, err
+	}
+	return Card{Suit: suit, Rank: rank}, nil
+}
+
+// NewCardsFromString parses a comma-separated list of card shorthands, e.g.
+// "As,Kd,Th", for building scripted hands in tests.
+func NewCardsFromString(s string) ([]Card, error) {
+	var cards []Card
+	for _, shorthand := range strings.Split(s, ",") {
+		card, err := NewCardFromString(strings.TrimSpace(shorthand))
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
@@ -0,0 +1,113 @@
+package blackjack
+
+// Action is a decision a player can make on a hand.
+type Action int
+
+const (
+	ActionHit Action = iota
+	ActionStand
+	ActionDouble
+	ActionSplit
+	ActionSurrender
+	ActionInsurance
+)
+
+func (a Action) String() string {
+	switch a {
+	case ActionHit:
+		return "hit"
+	case ActionStand:
+		return "stand"
+	case ActionDouble:
+		return "double"
+	case ActionSplit:
+		return "split"
+	case ActionSurrender:
+		return "surrender"
+	case ActionInsurance:
+		return "insurance"
+	}
+	return "unknown"
+}
+
+// Hand is one concurrent hand a player is holding. A player starts a round
+// with exactly one Hand, and gains additional ones by splitting pairs.
+type Hand struct {
+	Cards        []Card
+	Bet          int
+	InsuranceBet int
+	Surrendered  bool
+	// FromSplit marks a hand that was created by splitting a pair, since a
+	// handful of actions (surrender, insurance) only apply to a player's
+	// original two cards.
+	FromSplit bool
+	// SplitDepth counts how many times this hand's lineage has already
+	// been split, so Rules.MaxResplits can cap further splitting.
+	SplitDepth int
+}
+
+// valueAndSoftAces computes the hand's blackjack value, demoting aces from
+// 11 to 1 as needed to avoid busting, and reports how many aces are still
+// counted as 11 once demotion stops.
+func (h *Hand) valueAndSoftAces() (value int, softAces int) {
+	var aceCount int
+	for _, card := range h.Cards {
+		value += card.Value()
+		if card.Value() == 11 {
+			aceCount++
+		}
+	}
+
+	for value > 21 && aceCount > 0 {
+		value -= 10
+		aceCount--
+	}
+	return value, aceCount
+}
+
+// Value returns the blackjack value of the hand, adjusting aces down from
+// 11 to 1 as needed to avoid busting.
+func (h *Hand) Value() int {
+	value, _ := h.valueAndSoftAces()
+	return value
+}
+
+// IsSoft reports whether the hand is still counting an ace as 11.
+func (h *Hand) IsSoft() bool {
+	_, softAces := h.valueAndSoftAces()
+	return softAces > 0
+}
+
+// legalActions returns the actions a player may choose from for hand, given
+// the dealer's up card and the table rules in effect.
+func legalActions(hand *Hand, dealerUpCard Card, rules Rules) []Action {
+	actions := []Action{ActionStand, ActionHit}
+
+	if len(hand.Cards) == 2 {
+		if !hand.FromSplit || rules.DoubleAfterSplit {
+			actions = append(actions, ActionDouble)
+		}
+
+		if hand.Cards[0].Value() == hand.Cards[1].Value() && hand.SplitDepth < rules.MaxResplits {
+			if hand.Cards[0].Rank != Ace || !hand.FromSplit || rules.ResplitAces {
+				actions = append(actions, ActionSplit)
+			}
+		}
+
+		if !hand.FromSplit && rules.SurrenderAllowed {
+			actions = append(actions, ActionSurrender)
+		}
+	}
+
+	return actions
+}
+
+// hasLegalAction reports whether action is one of the choices offered.
+func hasLegalAction(legal []Action, action Action) bool {
+	for _, a := range legal {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
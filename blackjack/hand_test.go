@@ -0,0 +1,88 @@
+package blackjack
+
+import "testing"
+
+func mustCards(t *testing.T, s string) []Card {
+	t.Helper()
+	cards, err := NewCardsFromString(s)
+	if err != nil {
+		t.Fatalf("NewCardsFromString(%q): %v", s, err)
+	}
+	return cards
+}
+
+func TestHandValueAndIsSoft(t *testing.T) {
+	tests := []struct {
+		name     string
+		cards    string
+		value    int
+		wantSoft bool
+	}{
+		{"hard total", "Td,6h", 16, false},
+		{"soft total", "As,6h", 17, true},
+		{"blackjack", "As,Kd", 21, true},
+		{"ace demoted by a third card", "Td,6h,As", 17, false},
+		{"two aces demote to hard 12", "Ah,Ad,Tc", 12, false},
+		{"single ace demotes on its own", "As,5h,8c", 14, false},
+		{"soft hand that still has room to hit", "As,2h", 13, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := &Hand{Cards: mustCards(t, tt.cards)}
+			if got := hand.Value(); got != tt.value {
+				t.Errorf("Value() = %d, want %d", got, tt.value)
+			}
+			if got := hand.IsSoft(); got != tt.wantSoft {
+				t.Errorf("IsSoft() = %v, want %v", got, tt.wantSoft)
+			}
+		})
+	}
+}
+
+func TestLegalActions(t *testing.T) {
+	rules := VegasStripRules()
+	dealerUpCard := mustCards(t, "Tc")[0]
+
+	t.Run("fresh pair offers split and double", func(t *testing.T) {
+		hand := &Hand{Cards: mustCards(t, "8h,8c")}
+		legal := legalActions(hand, dealerUpCard, rules)
+		for _, want := range []Action{ActionHit, ActionStand, ActionDouble, ActionSplit, ActionSurrender} {
+			if !hasLegalAction(legal, want) {
+				t.Errorf("legalActions() missing %s", want)
+			}
+		}
+	})
+
+	t.Run("hand from split cannot resurrender", func(t *testing.T) {
+		hand := &Hand{Cards: mustCards(t, "8h,8c"), FromSplit: true}
+		legal := legalActions(hand, dealerUpCard, rules)
+		if hasLegalAction(legal, ActionSurrender) {
+			t.Errorf("legalActions() offered surrender on a split hand")
+		}
+	})
+
+	t.Run("split aces cannot be resplit under VegasStripRules", func(t *testing.T) {
+		hand := &Hand{Cards: mustCards(t, "As,Ah"), FromSplit: true, SplitDepth: 1}
+		legal := legalActions(hand, dealerUpCard, rules)
+		if hasLegalAction(legal, ActionSplit) {
+			t.Errorf("legalActions() offered split on already-split aces")
+		}
+	})
+
+	t.Run("three or more cards cannot double or split", func(t *testing.T) {
+		hand := &Hand{Cards: mustCards(t, "6h,6c,2d")}
+		legal := legalActions(hand, dealerUpCard, rules)
+		if hasLegalAction(legal, ActionDouble) || hasLegalAction(legal, ActionSplit) {
+			t.Errorf("legalActions() offered double/split on a 3-card hand: %v", legal)
+		}
+	})
+
+	t.Run("MaxResplits caps further splitting", func(t *testing.T) {
+		hand := &Hand{Cards: mustCards(t, "6h,6c"), FromSplit: true, SplitDepth: rules.MaxResplits}
+		legal := legalActions(hand, dealerUpCard, rules)
+		if hasLegalAction(legal, ActionSplit) {
+			t.Errorf("legalActions() offered split past MaxResplits")
+		}
+	})
+}
@@ -0,0 +1,245 @@
+package blackjack
+
+import "fmt"
+
+// PlayAlgorithm decides the next action for a hand, given the player's
+// state, the hand itself, the dealer's up card, and the actions currently
+// legal for that hand.
+type PlayAlgorithm func(player *Player, hand *Hand, dealerUpCard Card, legal []Action) Action
+
+// BetAlgorithm decides how much to bet at the start of a round, given the
+// player's state and how many decks remain in the shoe.
+type BetAlgorithm func(player *Player, decksRemaining float64) int
+
+type Player struct {
+	Name    string
+	Hands   []*Hand
+	Balance int
+
+	GamesPlayed int
+	Wins        int
+	Losses      int
+	WinStreak   int
+	LoseStreak  int
+
+	// RunningCount is a card counter's running Hi-Lo count, updated as
+	// cards are revealed across hands. It is meaningless for players who
+	// don't count cards.
+	RunningCount int
+
+	PlayAlgorithm PlayAlgorithm
+	BetAlgorithm  BetAlgorithm
+}
+
+func NewPlayer(name string, startingBalance int, playAlgorithm PlayAlgorithm, betAlgorithm BetAlgorithm) *Player {
+	return &Player{
+		Name:          name,
+		Balance:       startingBalance,
+		PlayAlgorithm: playAlgorithm,
+		BetAlgorithm:  betAlgorithm,
+	}
+}
+
+// TrueCount converts the player's running count into a true count by
+// dividing it by the number of decks remaining in the shoe.
+func (p *Player) TrueCount(decksRemaining float64) float64 {
+	if decksRemaining <= 0 {
+		return 0
+	}
+	return float64(p.RunningCount) / decksRemaining
+}
+
+// ResetCount zeroes the player's running count. Call it whenever the shoe
+// is reshuffled, since a Hi-Lo count is only meaningful for the cards
+// dealt since the last shuffle.
+func (p *Player) ResetCount() {
+	p.RunningCount = 0
+}
+
+// drawer is satisfied by Shoe (and by any test double standing in for it).
+type drawer interface {
+	Draw() Card
+}
+
+// PlayHand walks every hand the player is holding (including hands created
+// mid-round by splitting) and plays it to completion, drawing from deck
+// under the given table rules.
+func (p *Player) PlayHand(deck drawer, dealerUpCard Card, rules Rules) {
+	for i := 0; i < len(p.Hands); i++ {
+		hand := p.Hands[i]
+
+		// Insurance is only offered once, on the original two cards, and
+		// only when the dealer is showing an ace.
+		if rules.InsuranceOffered && !hand.FromSplit && len(hand.Cards) == 2 && dealerUpCard.Rank == Ace {
+			if p.PlayAlgorithm(p, hand, dealerUpCard, []Action{ActionInsurance, ActionStand}) == ActionInsurance {
+				hand.InsuranceBet = hand.Bet / 2
+				p.Balance -= hand.InsuranceBet
+			}
+		}
+
+	handLoop:
+		for {
+			legal := legalActions(hand, dealerUpCard, rules)
+			action := p.PlayAlgorithm(p, hand, dealerUpCard, legal)
+
+			switch action {
+			case ActionHit:
+				hand.Cards = append(hand.Cards, deck.Draw())
+				if hand.Value() > 21 {
+					break handLoop
+				}
+			case ActionStand:
+				break handLoop
+			case ActionDouble:
+				p.Balance -= hand.Bet
+				hand.Bet *= 2
+				hand.Cards = append(hand.Cards, deck.Draw())
+				break handLoop
+			case ActionSplit:
+				newHand := &Hand{
+					Cards:      []Card{hand.Cards[1]},
+					Bet:        hand.Bet,
+					FromSplit:  true,
+					SplitDepth: hand.SplitDepth + 1,
+				}
+				hand.Cards = []Card{hand.Cards[0]}
+				hand.FromSplit = true
+				hand.SplitDepth++
+				p.Balance -= newHand.Bet
+
+				hand.Cards = append(hand.Cards, deck.Draw())
+				newHand.Cards = append(newHand.Cards, deck.Draw())
+				p.Hands = append(p.Hands, newHand)
+			case ActionSurrender:
+				hand.Surrendered = true
+				break handLoop
+			}
+		}
+	}
+}
+
+// compareHandWithDealer settles a single hand against the dealer's hand.
+func compareHandWithDealer(hand *Hand, dealerHand *Hand, rules Rules) string {
+	if hand.Value() > 21 {
+		return "lose"
+	}
+
+	if dealerHand.Value() > 21 {
+		return "win"
+	}
+
+	if hand.Value() > dealerHand.Value() {
+		return "win"
+	}
+
+	if hand.Value() == dealerHand.Value() {
+		return "push"
+	}
+
+	return "lose"
+}
+
+// CompareWithDealer reports the outcome of the player's first hand against
+// the dealer. It exists for callers that only care about the common
+// single-hand case; Reconcile settles every hand independently.
+func (p *Player) CompareWithDealer(dealer *Player, rules Rules) string {
+	return compareHandWithDealer(p.Hands[0], dealer.Hands[0], rules)
+}
+
+// Reconcile settles every hand the player played this round under rules:
+// insurance at 2:1, surrendered hands settled per rules.EarlySurrenderAllowed,
+// a natural blackjack at rules.BlackjackPayout, and the rest by comparing
+// against the dealer's hand.
+func (p *Player) Reconcile(dealer *Player, rules Rules) {
+	dealerHand := dealer.Hands[0]
+	dealerBlackjack := dealerHand.Value() == 21 && len(dealerHand.Cards) == 2
+
+	for _, hand := range p.Hands {
+		p.GamesPlayed++
+
+		if hand.InsuranceBet > 0 {
+			if dealerBlackjack {
+				p.Balance += hand.InsuranceBet * 3
+			}
+			hand.InsuranceBet = 0
+		}
+
+		if hand.Surrendered {
+			// Early surrender is forfeited before the dealer's hole card
+			// is checked, so it refunds half the bet even against a
+			// dealer blackjack. Late surrender's check happens after the
+			// dealer's peek, so a dealer blackjack voids it and the
+			// player loses the whole bet instead.
+			if rules.EarlySurrenderAllowed || !dealerBlackjack {
+				p.Balance += hand.Bet / 2
+			}
+			hand.Bet = 0
+			p.Losses++
+			p.LoseStreak++
+			p.WinStreak = 0
+			continue
+		}
+
+		natural := hand.Value() == 21 && len(hand.Cards) == 2 && !hand.FromSplit
+		outcome := compareHandWithDealer(hand, dealerHand, rules)
+		if natural && !dealerBlackjack {
+			// A natural beats any dealer hand that isn't also a natural,
+			// including a 21 the dealer drew to.
+			outcome = "win"
+		}
+
+		switch outcome {
+		case "win":
+			if natural {
+				p.Balance += int(float64(hand.Bet) * (1 + rules.BlackjackPayout))
+			} else {
+				p.Balance += hand.Bet * 2
+			}
+			hand.Bet = 0
+			p.WinStreak++
+			p.Wins++
+			p.LoseStreak = 0
+		case "push":
+			p.Balance += hand.Bet
+			hand.Bet = 0
+		default:
+			hand.Bet = 0
+			p.LoseStreak++
+			p.Losses++
+			p.WinStreak = 0
+		}
+	}
+}
+
+func (p *Player) PrintStatistics() string {
+	return fmt.Sprintf("%s has played %d games, won %d games, and lost %d games, with a win streak of %d, and a lose streak of %d, balance: $%d",
+		p.Name, p.GamesPlayed, p.Wins, p.Losses, p.WinStreak, p.LoseStreak, p.Balance)
+}
+
+// hiLoValue is a card's Hi-Lo counting value: low cards are worth +1,
+// middling cards are worth 0, and tens and aces are worth -1.
+func hiLoValue(card Card) int {
+	switch card.Rank {
+	case Two, Three, Four, Five, Six:
+		return 1
+	case Seven, Eight, Nine:
+		return 0
+	default:
+		return -1
+	}
+}
+
+// ObserveRound feeds the cards in p's own hands and the dealer's hands this
+// round into p's running count. Other players' cards are not counted.
+func ObserveRound(p *Player, dealer *Player) {
+	for _, hand := range p.Hands {
+		for _, card := range hand.Cards {
+			p.RunningCount += hiLoValue(card)
+		}
+	}
+	for _, hand := range dealer.Hands {
+		for _, card := range hand.Cards {
+			p.RunningCount += hiLoValue(card)
+		}
+	}
+}
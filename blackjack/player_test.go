@@ -0,0 +1,237 @@
+package blackjack
+
+import "testing"
+
+// queueDrawer deals cards off the front of a fixed list, for scripting
+// exactly which cards a test's PlayHand call draws next.
+type queueDrawer struct {
+	cards []Card
+}
+
+func (q *queueDrawer) Draw() Card {
+	card := q.cards[0]
+	q.cards = q.cards[1:]
+	return card
+}
+
+// scriptedPlay returns a PlayAlgorithm that plays the given actions in
+// order, one per call, regardless of hand state.
+func scriptedPlay(actions ...Action) PlayAlgorithm {
+	i := 0
+	return func(player *Player, hand *Hand, dealerUpCard Card, legal []Action) Action {
+		action := actions[i]
+		i++
+		return action
+	}
+}
+
+func TestPlayHandSplit(t *testing.T) {
+	player := NewPlayer("Test", 100, scriptedPlay(ActionSplit, ActionStand, ActionStand), nil)
+	player.Hands = []*Hand{{Cards: mustCards(t, "8h,8c"), Bet: 10}}
+	drawer := &queueDrawer{cards: mustCards(t, "2d,3d")}
+	dealerUpCard := mustCards(t, "Tc")[0]
+
+	player.PlayHand(drawer, dealerUpCard, VegasStripRules())
+
+	if len(player.Hands) != 2 {
+		t.Fatalf("len(Hands) = %d, want 2", len(player.Hands))
+	}
+	if player.Balance != 90 {
+		t.Errorf("Balance = %d, want 90 (one extra bet staked for the split)", player.Balance)
+	}
+	if !player.Hands[0].FromSplit || !player.Hands[1].FromSplit {
+		t.Errorf("both resulting hands should be marked FromSplit")
+	}
+}
+
+func TestPlayHandDouble(t *testing.T) {
+	player := NewPlayer("Test", 100, scriptedPlay(ActionDouble), nil)
+	player.Hands = []*Hand{{Cards: mustCards(t, "5h,6c"), Bet: 10}}
+	drawer := &queueDrawer{cards: mustCards(t, "Kd")}
+
+	player.PlayHand(drawer, mustCards(t, "Tc")[0], VegasStripRules())
+
+	hand := player.Hands[0]
+	if hand.Bet != 20 {
+		t.Errorf("Bet = %d, want 20", hand.Bet)
+	}
+	if player.Balance != 90 {
+		t.Errorf("Balance = %d, want 90", player.Balance)
+	}
+	if len(hand.Cards) != 3 {
+		t.Errorf("len(Cards) = %d, want 3 (exactly one extra card on double)", len(hand.Cards))
+	}
+}
+
+func TestPlayHandBustStopsOnlyThatHand(t *testing.T) {
+	// A bust on the first split hand must not cut the second hand's turn
+	// short; see PlayHand's handLoop.
+	player := NewPlayer("Test", 100, scriptedPlay(ActionSplit, ActionHit, ActionHit, ActionStand), nil)
+	player.Hands = []*Hand{{Cards: mustCards(t, "8h,8c"), Bet: 10}}
+	// Split deals hand 0 its Td, hand 1 its 2d; the first scripted Hit then
+	// deals hand 0 a second Td, busting it, and the second Hit deals hand 1
+	// its 3d.
+	drawer := &queueDrawer{cards: mustCards(t, "Td,2d,Td,3d")}
+
+	player.PlayHand(drawer, mustCards(t, "Tc")[0], VegasStripRules())
+
+	if len(player.Hands) != 2 {
+		t.Fatalf("len(Hands) = %d, want 2", len(player.Hands))
+	}
+	if player.Hands[0].Value() <= 21 {
+		t.Fatalf("first hand should have busted")
+	}
+	if len(player.Hands[1].Cards) != 3 {
+		t.Errorf("second hand should still have been dealt its turn: got %d cards", len(player.Hands[1].Cards))
+	}
+}
+
+func TestPlayHandSurrender(t *testing.T) {
+	player := NewPlayer("Test", 100, scriptedPlay(ActionSurrender), nil)
+	player.Hands = []*Hand{{Cards: mustCards(t, "6h,9c"), Bet: 10}}
+
+	player.PlayHand(&queueDrawer{}, mustCards(t, "6c")[0], VegasStripRules())
+
+	if !player.Hands[0].Surrendered {
+		t.Errorf("hand should be marked Surrendered")
+	}
+}
+
+func newDealer(t *testing.T, cards string) *Player {
+	t.Helper()
+	dealer := NewPlayer("Dealer", 0, nil, nil)
+	dealer.Hands = []*Hand{{Cards: mustCards(t, cards)}}
+	return dealer
+}
+
+func TestReconcile(t *testing.T) {
+	rules := VegasStripRules()
+
+	t.Run("blackjack pays 3:2", func(t *testing.T) {
+		player := NewPlayer("Test", 0, nil, nil)
+		player.Hands = []*Hand{{Cards: mustCards(t, "As,Kd"), Bet: 10}}
+		dealer := newDealer(t, "Td,7c")
+
+		player.Reconcile(dealer, rules)
+
+		if player.Balance != 25 {
+			t.Errorf("Balance = %d, want 25 (bet back plus 1.5x payout)", player.Balance)
+		}
+		if player.Wins != 1 {
+			t.Errorf("Wins = %d, want 1", player.Wins)
+		}
+	})
+
+	t.Run("push returns the bet", func(t *testing.T) {
+		player := NewPlayer("Test", 0, nil, nil)
+		player.Hands = []*Hand{{Cards: mustCards(t, "Td,8c"), Bet: 10}}
+		dealer := newDealer(t, "Th,8d")
+
+		player.Reconcile(dealer, rules)
+
+		if player.Balance != 10 {
+			t.Errorf("Balance = %d, want 10", player.Balance)
+		}
+	})
+
+	t.Run("bust loses the bet", func(t *testing.T) {
+		player := NewPlayer("Test", 0, nil, nil)
+		player.Hands = []*Hand{{Cards: mustCards(t, "Td,8c,6h"), Bet: 10}}
+		dealer := newDealer(t, "Th,8d")
+
+		player.Reconcile(dealer, rules)
+
+		if player.Balance != 0 {
+			t.Errorf("Balance = %d, want 0", player.Balance)
+		}
+		if player.Losses != 1 {
+			t.Errorf("Losses = %d, want 1", player.Losses)
+		}
+	})
+
+	t.Run("surrender refunds half the bet", func(t *testing.T) {
+		player := NewPlayer("Test", 0, nil, nil)
+		player.Hands = []*Hand{{Cards: mustCards(t, "6h,9c"), Bet: 10, Surrendered: true}}
+		dealer := newDealer(t, "Th,8d")
+
+		player.Reconcile(dealer, rules)
+
+		if player.Balance != 5 {
+			t.Errorf("Balance = %d, want 5", player.Balance)
+		}
+	})
+
+	t.Run("late surrender is voided by a dealer blackjack", func(t *testing.T) {
+		player := NewPlayer("Test", 0, nil, nil)
+		player.Hands = []*Hand{{Cards: mustCards(t, "6h,9c"), Bet: 10, Surrendered: true}}
+		dealer := newDealer(t, "As,Kd")
+
+		player.Reconcile(dealer, rules)
+
+		if player.Balance != 0 {
+			t.Errorf("Balance = %d, want 0 (late surrender doesn't apply against a dealer blackjack)", player.Balance)
+		}
+	})
+
+	t.Run("early surrender refunds half the bet even against a dealer blackjack", func(t *testing.T) {
+		earlyRules := rules
+		earlyRules.EarlySurrenderAllowed = true
+
+		player := NewPlayer("Test", 0, nil, nil)
+		player.Hands = []*Hand{{Cards: mustCards(t, "6h,9c"), Bet: 10, Surrendered: true}}
+		dealer := newDealer(t, "As,Kd")
+
+		player.Reconcile(dealer, earlyRules)
+
+		if player.Balance != 5 {
+			t.Errorf("Balance = %d, want 5 (early surrender still refunds half)", player.Balance)
+		}
+	})
+
+	t.Run("insurance pays 2:1 against a dealer blackjack", func(t *testing.T) {
+		player := NewPlayer("Test", 0, nil, nil)
+		player.Hands = []*Hand{{Cards: mustCards(t, "Td,8c"), Bet: 10, InsuranceBet: 5}}
+		dealer := newDealer(t, "As,Kd")
+
+		player.Reconcile(dealer, rules)
+
+		// Insurance pays 3x the insurance stake (2:1 profit), plus the
+		// main hand still loses to the dealer's blackjack.
+		if player.Balance != 15 {
+			t.Errorf("Balance = %d, want 15", player.Balance)
+		}
+	})
+
+	t.Run("split hands settle independently", func(t *testing.T) {
+		player := NewPlayer("Test", 0, nil, nil)
+		player.Hands = []*Hand{
+			{Cards: mustCards(t, "8h,Td"), Bet: 10, FromSplit: true},
+			{Cards: mustCards(t, "8c,2d,2h"), Bet: 10, FromSplit: true},
+		}
+		dealer := newDealer(t, "Th,8d")
+
+		player.Reconcile(dealer, rules)
+
+		// First hand (18) beats the dealer's 18? no - pushes. Second hand
+		// (12) loses outright.
+		if player.Balance != 10 {
+			t.Errorf("Balance = %d, want 10 (one push, one loss)", player.Balance)
+		}
+	})
+}
+
+func TestResetCount(t *testing.T) {
+	player := NewPlayer("Counter", 0, nil, nil)
+	player.Hands = []*Hand{{Cards: mustCards(t, "2h,3d")}}
+	dealer := newDealer(t, "Tc,6s")
+
+	ObserveRound(player, dealer)
+	if player.RunningCount == 0 {
+		t.Fatalf("ObserveRound should have moved the running count off zero")
+	}
+
+	player.ResetCount()
+	if player.RunningCount != 0 {
+		t.Errorf("RunningCount = %d, want 0 after ResetCount (a fresh shoe has no history)", player.RunningCount)
+	}
+}
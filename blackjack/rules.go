@@ -0,0 +1,103 @@
+package blackjack
+
+// Rules captures the table rules a round is played under, so the same
+// player algorithms can be benchmarked against different houses.
+type Rules struct {
+	// BlackjackPayout is the profit ratio paid on a natural blackjack,
+	// e.g. 1.5 for 3:2 or 1.2 for 6:5.
+	BlackjackPayout float64
+
+	DealerHitsSoft17 bool
+	DoubleAfterSplit bool
+	MaxResplits      int
+	ResplitAces      bool
+
+	// SurrenderAllowed offers surrender at all. EarlySurrenderAllowed
+	// distinguishes early surrender (forfeited before the dealer checks
+	// their hole card for blackjack, so the player gets half their bet
+	// back even against a dealer blackjack) from late surrender (the
+	// Vegas Strip/Atlantic City standard, where the dealer's blackjack
+	// check happens first and voids the surrender, costing the player
+	// their whole bet).
+	SurrenderAllowed      bool
+	EarlySurrenderAllowed bool
+
+	InsuranceOffered bool
+
+	NumDecks    int
+	Penetration float64
+
+	MinBet int
+	MaxBet int
+}
+
+// ClampBet constrains bet to the table's min and max bet under rules. A
+// zero MinBet or MaxBet is treated as "no limit" on that side.
+func ClampBet(bet int, rules Rules) int {
+	if rules.MinBet > 0 && bet < rules.MinBet {
+		return rules.MinBet
+	}
+	if rules.MaxBet > 0 && bet > rules.MaxBet {
+		return rules.MaxBet
+	}
+	return bet
+}
+
+// VegasStripRules matches the player-friendly rules typically found on the
+// Las Vegas strip: 3:2 blackjack, dealer stands on soft 17, and surrender
+// on the table.
+func VegasStripRules() Rules {
+	return Rules{
+		BlackjackPayout:       1.5,
+		DealerHitsSoft17:      false,
+		DoubleAfterSplit:      true,
+		MaxResplits:           3,
+		ResplitAces:           false,
+		SurrenderAllowed:      true,
+		EarlySurrenderAllowed: false,
+		InsuranceOffered:      true,
+		NumDecks:              4,
+		Penetration:           0.75,
+		MinBet:                10,
+		MaxBet:                5000,
+	}
+}
+
+// AtlanticCityRules matches the rules mandated for Atlantic City casinos:
+// an eight-deck shoe, late surrender, and otherwise player-friendly.
+func AtlanticCityRules() Rules {
+	return Rules{
+		BlackjackPayout:       1.5,
+		DealerHitsSoft17:      false,
+		DoubleAfterSplit:      true,
+		MaxResplits:           3,
+		ResplitAces:           false,
+		SurrenderAllowed:      true,
+		EarlySurrenderAllowed: false,
+		InsuranceOffered:      true,
+		NumDecks:              8,
+		Penetration:           0.75,
+		MinBet:                10,
+		MaxBet:                5000,
+	}
+}
+
+// DowntownVegasRules matches the worse-for-the-player rules common in
+// downtown Vegas: a single deck, 6:5 blackjack, dealer hits soft 17, and no
+// surrender.
+func DowntownVegasRules() Rules {
+	return Rules{
+		BlackjackPayout:       1.2,
+		DealerHitsSoft17:      true,
+		DoubleAfterSplit:      false,
+		MaxResplits:           1,
+		ResplitAces:           false,
+		SurrenderAllowed:      false,
+		EarlySurrenderAllowed: false,
+		InsuranceOffered:      true,
+		NumDecks:              1,
+		Penetration:           0.6,
+		MinBet:                5,
+		MaxBet:                500,
+	}
+}
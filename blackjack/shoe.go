@@ -0,0 +1,89 @@
+package blackjack
+
+import "math/rand"
+
+// Shoe is a multi-deck dealing shoe with a cut card at a configurable
+// penetration. It is dealt from across many hands; NeedsShuffle reports
+// when the cut card is reached, and Draw reshuffles on its own only if the
+// shoe runs dry mid-round.
+type Shoe struct {
+	Cards []Card
+
+	NumDecks    int
+	Penetration float64
+
+	// cutCardRemaining is the number of cards left in the shoe at the
+	// point the cut card is reached.
+	cutCardRemaining int
+	rng              *rand.Rand
+}
+
+// NewShoe builds a shoe of numDecks standard 52-card decks, with a cut card
+// placed penetration of the way through, shuffled deterministically from
+// seed.
+func NewShoe(numDecks int, penetration float64, seed int64) *Shoe {
+	s := &Shoe{NumDecks: numDecks, Penetration: penetration}
+	s.ShuffleDeterministically(seed)
+	return s
+}
+
+// ShuffleDeterministically reseeds the shoe's random source and refills and
+// shuffles it, so that a simulation run can be reproduced exactly by
+// starting from the same seed.
+func (s *Shoe) ShuffleDeterministically(seed int64) {
+	s.rng = rand.New(rand.NewSource(seed))
+	s.fillAndShuffle()
+}
+
+// Shuffle refills and reshuffles the shoe using its existing random source.
+// Call it once the cut card has been reached and the hand in progress is
+// over.
+func (s *Shoe) Shuffle() {
+	s.fillAndShuffle()
+}
+
+func (s *Shoe) fillAndShuffle() {
+	s.Cards = nil
+	for i := 0; i < s.NumDecks; i++ {
+		for _, suit := range Suits {
+			for _, rank := range Ranks {
+				s.Cards = append(s.Cards, Card{Suit: suit, Rank: rank})
+			}
+		}
+	}
+	s.rng.Shuffle(len(s.Cards), func(i, j int) {
+		s.Cards[i], s.Cards[j] = s.Cards[j], s.Cards[i]
+	})
+
+	total := s.NumDecks * 52
+	s.cutCardRemaining = total - int(float64(total)*s.Penetration)
+}
+
+// NeedsShuffle reports whether the cut card has been reached.
+func (s *Shoe) NeedsShuffle() bool {
+	return len(s.Cards) <= s.cutCardRemaining
+}
+
+// CardsRemaining returns the number of cards left undealt in the shoe.
+func (s *Shoe) CardsRemaining() int {
+	return len(s.Cards)
+}
+
+// DecksRemaining returns, as a fraction, how many decks' worth of cards are
+// left undealt. Counting strategies divide the running count by this to
+// get a true count.
+func (s *Shoe) DecksRemaining() float64 {
+	return float64(len(s.Cards)) / 52.0
+}
+
+// Draw deals the next card from the shoe. A round in progress can run the
+// shoe past its cut card before anyone checks NeedsShuffle, so Draw
+// reshuffles itself if it would otherwise be asked to deal from empty.
+func (s *Shoe) Draw() Card {
+	if len(s.Cards) == 0 {
+		s.Shuffle()
+	}
+	card := s.Cards[0]
+	s.Cards = s.Cards[1:]
+	return card
+}
@@ -0,0 +1,58 @@
+package blackjack
+
+import "testing"
+
+func TestShoeNeedsShuffleAtPenetration(t *testing.T) {
+	shoe := NewShoe(1, 0.5, 1)
+	total := shoe.CardsRemaining()
+
+	dealt := 0
+	for !shoe.NeedsShuffle() {
+		shoe.Draw()
+		dealt++
+		if dealt > total {
+			t.Fatalf("NeedsShuffle never became true")
+		}
+	}
+
+	if dealt < total/2 {
+		t.Errorf("shuffled after dealing only %d of %d cards, expected roughly half", dealt, total)
+	}
+}
+
+func TestShoeDrawReshufflesWhenExhausted(t *testing.T) {
+	// A single-deck shoe with no penetration margin can still be drawn
+	// past its cut card mid-round (e.g. several players splitting); Draw
+	// must reshuffle rather than index past the end.
+	shoe := NewShoe(1, 0.99, 1)
+
+	for i := 0; i < 52; i++ {
+		shoe.Draw()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("Draw panicked on an exhausted shoe: %v", r)
+		}
+	}()
+
+	card := shoe.Draw()
+	if card.Rank == 0 {
+		t.Errorf("Draw() returned a zero-value card after reshuffling")
+	}
+	if shoe.CardsRemaining() != 51 {
+		t.Errorf("CardsRemaining() = %d, want 51 after reshuffle-and-draw", shoe.CardsRemaining())
+	}
+}
+
+func TestShoeShuffleDeterministically(t *testing.T) {
+	a := NewShoe(2, 0.75, 42)
+	b := NewShoe(2, 0.75, 42)
+
+	for i := 0; i < 10; i++ {
+		ca, cb := a.Draw(), b.Draw()
+		if ca != cb {
+			t.Fatalf("card %d differs between shoes seeded identically: %v != %v", i, ca, cb)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package blackjack
+
+import "math"
+
+// SimStats aggregates the results of many simulated rounds played by a
+// single algorithm, for comparing strategies head to head.
+type SimStats struct {
+	Algorithm string
+
+	Hands    int
+	totalNet int
+	nets     []int
+
+	handsPlayed    int
+	bustHands      int
+	blackjackHands int
+
+	balance           int
+	peakBalance       int
+	maxDrawdown       int
+	currentStreak     int
+	longestWinStreak  int
+	longestLoseStreak int
+}
+
+// NewSimStats starts a stats collector for algorithm, tracking drawdown
+// relative to startingBalance.
+func NewSimStats(algorithm string, startingBalance int) *SimStats {
+	return &SimStats{
+		Algorithm:   algorithm,
+		balance:     startingBalance,
+		peakBalance: startingBalance,
+	}
+}
+
+// RecordRound folds one round's outcome into the stats: balanceBefore and
+// balanceAfter are the player's balance either side of the round, and hands
+// are every hand the player played that round (more than one if they
+// split).
+func (s *SimStats) RecordRound(balanceBefore, balanceAfter int, hands []*Hand) {
+	net := balanceAfter - balanceBefore
+
+	s.Hands++
+	s.totalNet += net
+	s.nets = append(s.nets, net)
+
+	s.balance = balanceAfter
+	if s.balance > s.peakBalance {
+		s.peakBalance = s.balance
+	}
+	if drawdown := s.peakBalance - s.balance; drawdown > s.maxDrawdown {
+		s.maxDrawdown = drawdown
+	}
+
+	switch {
+	case net > 0:
+		if s.currentStreak < 0 {
+			s.currentStreak = 0
+		}
+		s.currentStreak++
+		if s.currentStreak > s.longestWinStreak {
+			s.longestWinStreak = s.currentStreak
+		}
+	case net < 0:
+		if s.currentStreak > 0 {
+			s.currentStreak = 0
+		}
+		s.currentStreak--
+		if -s.currentStreak > s.longestLoseStreak {
+			s.longestLoseStreak = -s.currentStreak
+		}
+	default:
+		s.currentStreak = 0
+	}
+
+	for _, hand := range hands {
+		s.handsPlayed++
+		if hand.Value() > 21 {
+			s.bustHands++
+		}
+		if !hand.FromSplit && len(hand.Cards) == 2 && hand.Value() == 21 {
+			s.blackjackHands++
+		}
+	}
+}
+
+// ExpectedValue is the mean net result per round.
+func (s *SimStats) ExpectedValue() float64 {
+	if s.Hands == 0 {
+		return 0
+	}
+	return float64(s.totalNet) / float64(s.Hands)
+}
+
+// StdDev is the standard deviation of the net result per round.
+func (s *SimStats) StdDev() float64 {
+	if len(s.nets) == 0 {
+		return 0
+	}
+	mean := s.ExpectedValue()
+	var sumSquares float64
+	for _, net := range s.nets {
+		d := float64(net) - mean
+		sumSquares += d * d
+	}
+	return math.Sqrt(sumSquares / float64(len(s.nets)))
+}
+
+// MaxDrawdown is the largest drop from a balance peak seen across the run.
+func (s *SimStats) MaxDrawdown() int {
+	return s.maxDrawdown
+}
+
+// LongestWinStreak and LongestLoseStreak count consecutive rounds with a
+// positive or negative net result, respectively.
+func (s *SimStats) LongestWinStreak() int  { return s.longestWinStreak }
+func (s *SimStats) LongestLoseStreak() int { return s.longestLoseStreak }
+
+// BustRate is the fraction of individual hands (including split hands)
+// that busted.
+func (s *SimStats) BustRate() float64 {
+	if s.handsPlayed == 0 {
+		return 0
+	}
+	return float64(s.bustHands) / float64(s.handsPlayed)
+}
+
+// BlackjackRate is the fraction of individual hands dealt a natural
+// blackjack.
+func (s *SimStats) BlackjackRate() float64 {
+	if s.handsPlayed == 0 {
+		return 0
+	}
+	return float64(s.blackjackHands) / float64(s.handsPlayed)
+}
+
+// SimStatsSummary is the machine-readable snapshot of a SimStats, suitable
+// for JSON output.
+type SimStatsSummary struct {
+	Algorithm string `json:"algorithm"`
+
+	// Rounds is the number of rounds dealt; ExpectedValue and StdDev are
+	// per round. HandsPlayed is the number of individual hands played,
+	// which is larger than Rounds whenever a round included a split, and
+	// is the denominator behind BustRate and BlackjackRate.
+	Rounds      int `json:"rounds"`
+	HandsPlayed int `json:"hands_played"`
+
+	ExpectedValue     float64 `json:"expected_value"`
+	StdDev            float64 `json:"std_dev"`
+	MaxDrawdown       int     `json:"max_drawdown"`
+	LongestWinStreak  int     `json:"longest_win_streak"`
+	LongestLoseStreak int     `json:"longest_lose_streak"`
+	BustRate          float64 `json:"bust_rate"`
+	BlackjackRate     float64 `json:"blackjack_rate"`
+}
+
+// Summary returns a snapshot of the stats collected so far.
+func (s *SimStats) Summary() SimStatsSummary {
+	return SimStatsSummary{
+		Algorithm:         s.Algorithm,
+		Rounds:            s.Hands,
+		HandsPlayed:       s.handsPlayed,
+		ExpectedValue:     s.ExpectedValue(),
+		StdDev:            s.StdDev(),
+		MaxDrawdown:       s.maxDrawdown,
+		LongestWinStreak:  s.longestWinStreak,
+		LongestLoseStreak: s.longestLoseStreak,
+		BustRate:          s.BustRate(),
+		BlackjackRate:     s.BlackjackRate(),
+	}
+}
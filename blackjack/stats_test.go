@@ -0,0 +1,20 @@
+package blackjack
+
+import "testing"
+
+func TestSimStatsSummarySplitRound(t *testing.T) {
+	stats := NewSimStats("test", 100)
+	hands := []*Hand{
+		{Cards: mustCards(t, "8h,Td"), FromSplit: true},
+		{Cards: mustCards(t, "8c,2d,2h"), FromSplit: true},
+	}
+	stats.RecordRound(100, 90, hands)
+
+	summary := stats.Summary()
+	if summary.Rounds != 1 {
+		t.Errorf("Rounds = %d, want 1", summary.Rounds)
+	}
+	if summary.HandsPlayed != 2 {
+		t.Errorf("HandsPlayed = %d, want 2 (one round, two split hands)", summary.HandsPlayed)
+	}
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mathyourlife/blackjack/blackjack"
+)
+
+// bot pairs up a named PlayAlgorithm/BetAlgorithm so --players can select
+// strategies by name in --sim mode.
+type bot struct {
+	Play blackjack.PlayAlgorithm
+	Bet  blackjack.BetAlgorithm
+}
+
+var bots = map[string]bot{
+	"bruce":   {blackjack.BrucePlayAlgorithm, blackjack.BruceBetAlgorithm},
+	"counter": {blackjack.BasicStrategyPlayAlgorithm, blackjack.CountingBetAlgorithm},
+}
+
+// rulesPresets maps --rules names to the table rules they select.
+var rulesPresets = map[string]func() blackjack.Rules{
+	"vegas-strip":    blackjack.VegasStripRules,
+	"atlantic-city":  blackjack.AtlanticCityRules,
+	"downtown-vegas": blackjack.DowntownVegasRules,
+}
+
+func main() {
+	sim := flag.Bool("sim", false, "run headless, drawing no prompts, and print aggregate statistics")
+	hands := flag.Int("hands", 10000, "number of rounds to deal in --sim mode")
+	players := flag.String("players", "bruce,counter", "comma-separated bot strategies to simulate: bruce, counter")
+	seed := flag.Int64("seed", time.Now().UnixNano(), "shoe shuffle seed, for reproducible --sim runs")
+	rulesName := flag.String("rules", "vegas-strip", "table rules preset: vegas-strip, atlantic-city, downtown-vegas")
+	decks := flag.Int("decks", 0, "number of decks in the shoe (0 = use the rules preset's default)")
+	penetration := flag.Float64("penetration", 0, "fraction of the shoe dealt before reshuffling (0 = use the rules preset's default)")
+	flag.Parse()
+
+	newRules, ok := rulesPresets[*rulesName]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown rules preset %q\n", *rulesName)
+		os.Exit(1)
+	}
+	rules := newRules()
+	if *decks > 0 {
+		rules.NumDecks = *decks
+	}
+	if *penetration > 0 {
+		rules.Penetration = *penetration
+	}
+
+	if *sim {
+		runSimulation(*hands, strings.Split(*players, ","), *seed, rules)
+		return
+	}
+
+	runInteractive(rules)
+}
+
+func runSimulation(hands int, playerNames []string, seed int64, rules blackjack.Rules) {
+	dealer := blackjack.NewPlayer("Dealer", 0, blackjack.NewDealerPlayAlgorithm(rules.DealerHitsSoft17), blackjack.DealerBetAlgorithm)
+
+	var players []*blackjack.Player
+	var stats []*blackjack.SimStats
+	for _, name := range playerNames {
+		name = strings.TrimSpace(name)
+		b, ok := bots[name]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "unknown bot %q, skipping\n", name)
+			continue
+		}
+		p := blackjack.NewPlayer(name, 10000, b.Play, b.Bet)
+		players = append(players, p)
+		stats = append(stats, blackjack.NewSimStats(name, p.Balance))
+	}
+
+	shoe := blackjack.NewShoe(rules.NumDecks, rules.Penetration, seed)
+	balanceBefore := make([]int, len(players))
+
+	for h := 0; h < hands; h++ {
+		if shoe.NeedsShuffle() {
+			shoe.Shuffle()
+			for _, p := range players {
+				p.ResetCount()
+			}
+		}
+
+		dealer.Hands = []*blackjack.Hand{{}}
+		for i, p := range players {
+			balanceBefore[i] = p.Balance
+			bet := blackjack.ClampBet(p.BetAlgorithm(p, shoe.DecksRemaining()), rules)
+			p.Balance -= bet
+			p.Hands = []*blackjack.Hand{{Bet: bet}}
+		}
+
+		for i := 0; i < 2; i++ {
+			for _, p := range players {
+				p.Hands[0].Cards = append(p.Hands[0].Cards, shoe.Draw())
+			}
+			dealer.Hands[0].Cards = append(dealer.Hands[0].Cards, shoe.Draw())
+		}
+
+		dealerUpCard := dealer.Hands[0].Cards[0]
+		for _, p := range players {
+			p.PlayHand(shoe, dealerUpCard, rules)
+		}
+		dealer.PlayHand(shoe, dealerUpCard, rules)
+
+		for i, p := range players {
+			p.Reconcile(dealer, rules)
+			blackjack.ObserveRound(p, dealer)
+			stats[i].RecordRound(balanceBefore[i], p.Balance, p.Hands)
+		}
+	}
+
+	printStatsTable(stats)
+	printStatsJSON(stats)
+}
+
+func printStatsTable(stats []*blackjack.SimStats) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "Algorithm\tRounds\tHands\tEV/Round\tStdDev\tMaxDrawdown\tWinStreak\tLoseStreak\tBustRate\tBlackjackRate")
+	for _, s := range stats {
+		summary := s.Summary()
+		fmt.Fprintf(w, "%s\t%d\t%d\t%.2f\t%.2f\t%d\t%d\t%d\t%.2f%%\t%.2f%%\n",
+			summary.Algorithm, summary.Rounds, summary.HandsPlayed, summary.ExpectedValue, summary.StdDev,
+			summary.MaxDrawdown, summary.LongestWinStreak, summary.LongestLoseStreak,
+			summary.BustRate*100, summary.BlackjackRate*100)
+	}
+	w.Flush()
+}
+
+func printStatsJSON(stats []*blackjack.SimStats) {
+	summaries := make([]blackjack.SimStatsSummary, len(stats))
+	for i, s := range stats {
+		summaries[i] = s.Summary()
+	}
+	out, err := json.MarshalIndent(summaries, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to marshal stats: %v\n", err)
+		return
+	}
+	fmt.Println(string(out))
+}
+
+func runInteractive(rules blackjack.Rules) {
+	fmt.Println("Welcome to Blackjack!")
+
+	dealer := blackjack.NewPlayer("Dealer", 0, blackjack.NewDealerPlayAlgorithm(rules.DealerHitsSoft17), blackjack.DealerBetAlgorithm)
+	bruce := blackjack.NewPlayer("Bruce", 100, blackjack.BrucePlayAlgorithm, blackjack.BruceBetAlgorithm)
+	human := blackjack.NewPlayer("Human", 100, blackjack.HumanPlayAlgorithm, blackjack.HumanBetAlgorithm)
+	counter := blackjack.NewPlayer("Counter", 100, blackjack.BasicStrategyPlayAlgorithm, blackjack.CountingBetAlgorithm)
+
+	shoe := blackjack.NewShoe(rules.NumDecks, rules.Penetration, time.Now().UnixNano())
+
+	for i := 0; i < 10000; i++ {
+		if shoe.NeedsShuffle() {
+			fmt.Println("\nCut card reached, shuffling the shoe...")
+			shoe.Shuffle()
+			counter.ResetCount()
+		}
+
+		// Place bets, clamped to the table's min/max under rules.
+		bruceBet := blackjack.ClampBet(bruce.BetAlgorithm(bruce, shoe.DecksRemaining()), rules)
+		bruce.Balance -= bruceBet
+
+		humanBet := blackjack.ClampBet(human.BetAlgorithm(human, shoe.DecksRemaining()), rules)
+		human.Balance -= humanBet
+
+		counterBet := blackjack.ClampBet(counter.BetAlgorithm(counter, shoe.DecksRemaining()), rules)
+		counter.Balance -= counterBet
+
+		bruce.Hands = []*blackjack.Hand{{Bet: bruceBet}}
+		human.Hands = []*blackjack.Hand{{Bet: humanBet}}
+		counter.Hands = []*blackjack.Hand{{Bet: counterBet}}
+		dealer.Hands = []*blackjack.Hand{{}}
+
+		// deal the cards
+		for i := 0; i < 2; i++ {
+			bruce.Hands[0].Cards = append(bruce.Hands[0].Cards, shoe.Draw())
+			human.Hands[0].Cards = append(human.Hands[0].Cards, shoe.Draw())
+			counter.Hands[0].Cards = append(counter.Hands[0].Cards, shoe.Draw())
+			dealer.Hands[0].Cards = append(dealer.Hands[0].Cards, shoe.Draw())
+		}
+
+		dealerUpCard := dealer.Hands[0].Cards[0]
+
+		// Reveal dealer's hand
+		fmt.Printf("\nDealer's hand: [%s] [x]\n", dealerUpCard.FormatForTerminal())
+
+		// First player
+		fmt.Printf("\nIt's %s's turn\n", bruce.Name)
+		fmt.Println(printHand(bruce.Hands[0].Cards))
+		fmt.Println(bruce.Hands[0].Value())
+		bruce.PlayHand(shoe, dealerUpCard, rules)
+
+		// Second player
+		fmt.Printf("\nIt's %s's turn\n", human.Name)
+		fmt.Println(printHand(human.Hands[0].Cards))
+		fmt.Println(human.Hands[0].Value())
+		human.PlayHand(shoe, dealerUpCard, rules)
+
+		// Third player
+		fmt.Printf("\nIt's %s's turn\n", counter.Name)
+		fmt.Println(printHand(counter.Hands[0].Cards))
+		fmt.Println(counter.Hands[0].Value())
+		counter.PlayHand(shoe, dealerUpCard, rules)
+
+		// Dealer last
+		fmt.Printf("\nIt's %s's turn\n", dealer.Name)
+		fmt.Println(printHand(dealer.Hands[0].Cards))
+		fmt.Println(dealer.Hands[0].Value())
+		dealer.PlayHand(shoe, dealerUpCard, rules)
+
+		fmt.Printf("Game #%d over!\n\n", i+1)
+
+		// Reconcile the bets and win/loss counts
+		bruce.Reconcile(dealer, rules)
+		human.Reconcile(dealer, rules)
+		counter.Reconcile(dealer, rules)
+
+		// The counter keeps a running Hi-Lo count across hands, updated
+		// once every card for the round has been revealed.
+		blackjack.ObserveRound(counter, dealer)
+
+		fmt.Printf("%s %s: %d %s\n", bruce.Name, bruce.CompareWithDealer(dealer, rules), bruce.Hands[0].Value(), printHand(bruce.Hands[0].Cards))
+		fmt.Printf("%s %s: %d %s\n", human.Name, human.CompareWithDealer(dealer, rules), human.Hands[0].Value(), printHand(human.Hands[0].Cards))
+		fmt.Printf("%s %s: %d %s\n", counter.Name, counter.CompareWithDealer(dealer, rules), counter.Hands[0].Value(), printHand(counter.Hands[0].Cards))
+
+		fmt.Println()
+		fmt.Println(bruce.PrintStatistics())
+		fmt.Println(human.PrintStatistics())
+		fmt.Println(counter.PrintStatistics())
+
+		// Done playing?
+		fmt.Println("Would you like to play again? 'yes' or 'no'")
+		var input string
+		fmt.Scanln(&input)
+		if input == "no" {
+			break
+		}
+	}
+}
+
+func printHand(cards []blackjack.Card) string {
+	formatted := make([]string, len(cards))
+	for i, card := range cards {
+		formatted[i] = card.FormatForTerminal()
+	}
+	return fmt.Sprintf("[%s]", strings.Join(formatted, ", "))
+}